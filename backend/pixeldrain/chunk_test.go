@@ -0,0 +1,55 @@
+package pixeldrain
+
+import (
+	"testing"
+)
+
+func TestChunkBounds(t *testing.T) {
+	for _, test := range []struct {
+		i, chunkSize, size int64
+		wantStart, wantN    int64
+	}{
+		{0, 10, 25, 0, 10},
+		{1, 10, 25, 10, 10},
+		{2, 10, 25, 20, 5},
+		{0, 10, 10, 0, 10},
+		{0, 10, 3, 0, 3},
+	} {
+		start, n := chunkBounds(int(test.i), test.chunkSize, test.size)
+		if start != test.wantStart || n != test.wantN {
+			t.Errorf("chunkBounds(%d, %d, %d) = (%d, %d), want (%d, %d)",
+				test.i, test.chunkSize, test.size, start, n, test.wantStart, test.wantN)
+		}
+	}
+}
+
+func TestParentPath(t *testing.T) {
+	for _, test := range []struct{ in, want string }{
+		{"/a/b/c", "/a/b"},
+		{"/a/b/c/", "/a/b"},
+		{"/a", ""},
+		{"a", ""},
+		{"", ""},
+	} {
+		if got := parentPath(test.in); got != test.want {
+			t.Errorf("parentPath(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestUploadIDFromChunks(t *testing.T) {
+	a := uploadIDFromChunks([]string{"aaa", "bbb"})
+	b := uploadIDFromChunks([]string{"aaa", "bbb"})
+	if a != b {
+		t.Errorf("uploadIDFromChunks is not deterministic: %q != %q", a, b)
+	}
+
+	c := uploadIDFromChunks([]string{"bbb", "aaa"})
+	if a == c {
+		t.Errorf("uploadIDFromChunks(%v) should differ from uploadIDFromChunks(%v)", []string{"aaa", "bbb"}, []string{"bbb", "aaa"})
+	}
+
+	if a == "" {
+		t.Error("uploadIDFromChunks returned an empty id")
+	}
+}
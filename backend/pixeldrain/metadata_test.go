@@ -0,0 +1,82 @@
+package pixeldrain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rclone/rclone/fs"
+)
+
+func TestMetadataParams(t *testing.T) {
+	options := []fs.OpenOption{&fs.MetadataOption{Metadata: fs.Metadata{
+		"mode_octal":    "0644",
+		"created":       "2024-01-02T15:04:05Z",
+		"property-key1": "value1",
+		"property-key2": "value2",
+		"unrelated":     "ignored",
+	}}}
+
+	params := metadataParams(options)
+	if params["mode_octal"] != "0644" {
+		t.Errorf("mode_octal = %q, want %q", params["mode_octal"], "0644")
+	}
+	if params["created"] != "2024-01-02T15:04:05Z" {
+		t.Errorf("created = %q, want %q", params["created"], "2024-01-02T15:04:05Z")
+	}
+	if _, ok := params["unrelated"]; ok {
+		t.Error("metadataParams should not pass through unknown keys")
+	}
+	if params["properties"] == "" {
+		t.Error("metadataParams should JSON-encode property- keys into \"properties\"")
+	}
+}
+
+func TestMetadataEqualityFields(t *testing.T) {
+	options := []fs.OpenOption{&fs.MetadataOption{Metadata: fs.Metadata{
+		"sha256_sum":    "abc123",
+		"mode_octal":    "0755",
+		"modified":      "2024-01-02T15:04:05Z",
+		"property-key1": "value1",
+	}}}
+
+	sha256Sum, modeOctal, modTime, properties := metadataEqualityFields(options)
+	if sha256Sum != "abc123" {
+		t.Errorf("sha256Sum = %q, want %q", sha256Sum, "abc123")
+	}
+	if modeOctal != "0755" {
+		t.Errorf("modeOctal = %q, want %q", modeOctal, "0755")
+	}
+	want, _ := time.Parse(time.RFC3339Nano, "2024-01-02T15:04:05Z")
+	if !modTime.Equal(want) {
+		t.Errorf("modTime = %v, want %v", modTime, want)
+	}
+	if properties["key1"] != "value1" {
+		t.Errorf("properties[key1] = %q, want %q", properties["key1"], "value1")
+	}
+}
+
+func TestObjectMetadataUnchanged(t *testing.T) {
+	modTime, _ := time.Parse(time.RFC3339Nano, "2024-01-02T15:04:05Z")
+	o := &Object{base: &FilesystemNode{
+		FileSize:  123,
+		SHA256Sum: "abc123",
+		ModeOctal: "0644",
+		Modified:  modTime,
+		Properties: map[string]string{
+			"key1": "value1",
+		},
+	}}
+
+	if !o.metadataUnchanged(123, "abc123", modTime, "0644", map[string]string{"key1": "value1"}) {
+		t.Error("expected metadataUnchanged to report true for identical metadata")
+	}
+	if o.metadataUnchanged(124, "abc123", modTime, "0644", map[string]string{"key1": "value1"}) {
+		t.Error("expected metadataUnchanged to report false on size mismatch")
+	}
+	if o.metadataUnchanged(123, "abc123", modTime, "0644", map[string]string{"key1": "other"}) {
+		t.Error("expected metadataUnchanged to report false on property mismatch")
+	}
+	if o.metadataUnchanged(123, "abc123", modTime, "0644", nil) {
+		t.Error("expected metadataUnchanged to report false when properties are missing")
+	}
+}
@@ -0,0 +1,272 @@
+package pixeldrain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/rclone/rclone/fs/config/configstruct"
+	"github.com/rclone/rclone/fs/fshttp"
+	"github.com/rclone/rclone/fs/hash"
+	"github.com/rclone/rclone/lib/rest"
+)
+
+const (
+	defaultAPIURL = "https://pixeldrain.com/api"
+	userEndpoint  = "/user"
+)
+
+// Options defines the configuration for this backend
+type Options struct {
+	APIKey                  string        `config:"api_key"`
+	APIURL                  string        `config:"api_url"`
+	ChunkSize               fs.SizeSuffix `config:"chunk_size"`
+	AboutCache              fs.Duration   `config:"about_cache"`
+	ServerSideAcrossConfigs bool          `config:"server_side_across_configs"`
+}
+
+// Fs represents a pixeldrain filesystem rooted at root
+type Fs struct {
+	name       string
+	root       string
+	opt        Options
+	features   *fs.Features
+	srv        *rest.Client
+	pathPrefix string
+	aboutCache aboutCache
+}
+
+// Object describes a pixeldrain file
+type Object struct {
+	fs       *Fs
+	base     *FilesystemNode
+	path     []FilesystemNode
+	children []FilesystemNode
+}
+
+func init() {
+	fs.Register(&fs.RegInfo{
+		Name:        "pixeldrain",
+		Description: "Pixeldrain Filesystem",
+		NewFs:       NewFs,
+		CommandHelp: commandHelp,
+		Options: []fs.Option{{
+			Name:      "api_key",
+			Help:      "API key for your pixeldrain account.",
+			Sensitive: true,
+		}, {
+			Name:     "api_url",
+			Help:     "The API endpoint to connect to. Don't change unless you know what you're doing.",
+			Default:  defaultAPIURL,
+			Advanced: true,
+		}, {
+			Name:     "chunk_size",
+			Help:     "Files larger than this are uploaded in resumable chunks of this size instead of a single PUT, when the data being uploaded supports random access (io.ReaderAt).",
+			Default:  fs.SizeSuffix(defaultChunkSize),
+			Advanced: true,
+		}, {
+			Name:     "about_cache",
+			Help:     "How long to cache the result of 'rclone about', so repeated calls during a sync don't hammer /user.",
+			Default:  fs.Duration(60 * time.Second),
+			Advanced: true,
+		}, {
+			Name: "server_side_across_configs",
+			Help: `Allow server-side operations (e.g. copy) to work across different pixeldrain configs.
+
+This can be useful if you wish to do a server-side copy or move between
+two remotes which point at the same pixeldrain account (or host) but are
+configured differently, e.g. with a different root.`,
+			Default:  false,
+			Advanced: true,
+		}},
+	})
+}
+
+// NewFs constructs a new Fs for root
+func NewFs(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error) {
+	opt := new(Options)
+	if err := configstruct.Set(m, opt); err != nil {
+		return nil, err
+	}
+	if opt.APIURL == "" {
+		opt.APIURL = defaultAPIURL
+	}
+
+	root = strings.Trim(root, "/")
+	f := &Fs{
+		name:       name,
+		root:       root,
+		opt:        *opt,
+		pathPrefix: "/" + root,
+		srv:        rest.NewClient(fshttp.NewClient(ctx)).SetRoot(opt.APIURL + "/filesystem").SetErrorHandler(apiErrorHandler),
+	}
+	if opt.APIKey != "" {
+		f.srv.SetUserPass(opt.APIKey, "")
+	}
+	f.features = (&fs.Features{
+		CanHaveEmptyDirectories: true,
+	}).Fill(ctx, f)
+
+	fsp, err := f.stat(ctx, f.pathPrefix)
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return f, nil
+		}
+		return nil, err
+	}
+	if fsp.Path[fsp.BaseIndex].Type != "dir" {
+		// root points at a file - rclone represents that by reporting the
+		// parent directory as the root and returning fs.ErrorIsFile
+		f.pathPrefix = parentPath(f.pathPrefix)
+		f.root = strings.TrimPrefix(f.pathPrefix, "/")
+		return f, fs.ErrorIsFile
+	}
+	return f, nil
+}
+
+// Name of the remote
+func (f *Fs) Name() string { return f.name }
+
+// Root of the remote
+func (f *Fs) Root() string { return f.root }
+
+// String converts this Fs to a string
+func (f *Fs) String() string { return fmt.Sprintf("pixeldrain root '%s'", f.root) }
+
+// Precision of the ModTimes in this Fs
+func (f *Fs) Precision() time.Duration { return time.Second }
+
+// Hashes returns the supported hash sets
+func (f *Fs) Hashes() hash.Set { return hash.Set(hash.SHA256) }
+
+// Features returns the optional features of this Fs
+func (f *Fs) Features() *fs.Features { return f.features }
+
+// List the objects and directories in dir into entries
+func (f *Fs) List(ctx context.Context, dir string) (entries fs.DirEntries, err error) {
+	fsp, err := f.stat(ctx, f.pathPrefix+dir)
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, fs.ErrorDirNotFound
+		}
+		return nil, err
+	}
+	for _, child := range fsp.Children {
+		if child.Type == "dir" {
+			entries = append(entries, f.nodeToDirectory(child))
+		} else {
+			entries = append(entries, f.nodeToObject(child))
+		}
+	}
+	return entries, nil
+}
+
+// NewObject finds the Object at remote
+func (f *Fs) NewObject(ctx context.Context, remote string) (fs.Object, error) {
+	fsp, err := f.stat(ctx, f.pathPrefix+remote)
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, fs.ErrorObjectNotFound
+		}
+		return nil, err
+	}
+	if fsp.Path[fsp.BaseIndex].Type == "dir" {
+		return nil, fs.ErrorIsDir
+	}
+	return f.pathToObject(fsp), nil
+}
+
+// Put uploads in as remote, implementing fs.Fs.
+func (f *Fs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	node, err := f.put(ctx, f.pathPrefix+src.Remote(), in, src.Size(), options)
+	if err != nil {
+		return nil, err
+	}
+	return f.nodeToObject(node), nil
+}
+
+// Mkdir creates the directory dir, and any missing parents
+func (f *Fs) Mkdir(ctx context.Context, dir string) error {
+	return f.mkdir(ctx, f.pathPrefix+dir)
+}
+
+// Rmdir removes the empty directory dir
+func (f *Fs) Rmdir(ctx context.Context, dir string) error {
+	return f.delete(ctx, f.pathPrefix+dir, false)
+}
+
+// Fs returns the parent Fs
+func (o *Object) Fs() fs.Info { return o.fs }
+
+// String returns the object's remote path
+func (o *Object) String() string { return o.base.Path }
+
+// Remote returns the object's remote path
+func (o *Object) Remote() string { return o.base.Path }
+
+// ModTime returns the modification time of the object
+func (o *Object) ModTime(ctx context.Context) time.Time { return o.base.Modified }
+
+// Size returns the size of the object in bytes
+func (o *Object) Size() int64 { return o.base.FileSize }
+
+// Hash returns the SHA256 of the object
+func (o *Object) Hash(ctx context.Context, ty hash.Type) (string, error) {
+	if ty != hash.SHA256 {
+		return "", hash.ErrUnsupported
+	}
+	return o.base.SHA256Sum, nil
+}
+
+// Storable returns whether this object can be stored
+func (o *Object) Storable() bool { return true }
+
+// SetModTime sets the modification time of the object
+func (o *Object) SetModTime(ctx context.Context, t time.Time) error {
+	node, err := o.fs.update(ctx, o.fs.pathPrefix+o.base.Path, map[string]any{"modified": t})
+	if err != nil {
+		return err
+	}
+	node.Path = strings.TrimPrefix(node.Path, o.fs.pathPrefix)
+	o.base = &node
+	return nil
+}
+
+// Open opens the object for reading
+func (o *Object) Open(ctx context.Context, options ...fs.OpenOption) (io.ReadCloser, error) {
+	return o.fs.read(ctx, o.fs.pathPrefix+o.base.Path, options)
+}
+
+// Update the object with the contents of in
+func (o *Object) Update(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) error {
+	node, err := o.fs.put(ctx, o.fs.pathPrefix+o.base.Path, in, src.Size(), options)
+	if err != nil {
+		return err
+	}
+	node.Path = strings.TrimPrefix(node.Path, o.fs.pathPrefix)
+	o.base = &node
+	return nil
+}
+
+// Remove deletes the object
+func (o *Object) Remove(ctx context.Context) error {
+	return o.fs.delete(ctx, o.fs.pathPrefix+o.base.Path, false)
+}
+
+// Check the interfaces are satisfied
+var (
+	_ fs.Fs           = (*Fs)(nil)
+	_ fs.Copier       = (*Fs)(nil)
+	_ fs.Mover        = (*Fs)(nil)
+	_ fs.DirMover     = (*Fs)(nil)
+	_ fs.Abouter      = (*Fs)(nil)
+	_ fs.PublicLinker = (*Fs)(nil)
+	_ fs.Commander    = (*Fs)(nil)
+	_ fs.Object       = (*Object)(nil)
+	_ fs.Metadataer   = (*Object)(nil)
+)